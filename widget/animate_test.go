@@ -0,0 +1,64 @@
+package widget
+
+import (
+	"image/color"
+	"reflect"
+	"testing"
+)
+
+type animatableFields struct {
+	Opacity    float32
+	Untouched  float32
+	Tint       color.Color
+	unexported float32
+}
+
+func TestSnapshotAnimatableFields(t *testing.T) {
+	f := &animatableFields{
+		Opacity:    0.5,
+		Untouched:  1,
+		Tint:       color.White,
+		unexported: 9,
+	}
+
+	snap := snapshotAnimatableFields(reflect.ValueOf(f).Elem())
+
+	if got, want := snap["Opacity"], float32(0.5); got != want {
+		t.Fatalf("Opacity = %v, want %v", got, want)
+	}
+	if got, want := snap["Tint"], color.Color(color.White); got != want {
+		t.Fatalf("Tint = %v, want %v", got, want)
+	}
+	if _, ok := snap["unexported"]; ok {
+		t.Fatalf("unexported field must not be snapshotted")
+	}
+}
+
+func TestLerpFloat32(t *testing.T) {
+	cases := []struct {
+		from, to, t, want float32
+	}{
+		{0, 10, 0, 0},
+		{0, 10, 1, 10},
+		{0, 10, 0.5, 5},
+		{4, 2, 0.5, 3},
+	}
+
+	for _, c := range cases {
+		if got := lerpFloat32(c.from, c.to, c.t); got != c.want {
+			t.Errorf("lerpFloat32(%v, %v, %v) = %v, want %v", c.from, c.to, c.t, got, c.want)
+		}
+	}
+}
+
+func TestLerpColor(t *testing.T) {
+	from := color.RGBA{R: 0, G: 0, B: 0, A: 255}
+	to := color.RGBA{R: 200, G: 0, B: 0, A: 255}
+
+	mid := lerpColor(from, to, 0.5)
+
+	r, _, _, _ := mid.RGBA()
+	if got, want := uint8(r>>8), uint8(100); got < want-1 || got > want+1 {
+		t.Fatalf("lerpColor midpoint red = %d, want ~%d", got, want)
+	}
+}
@@ -0,0 +1,36 @@
+package widget
+
+import (
+	"bytes"
+	"runtime"
+	"strconv"
+)
+
+// mainGoroutineID is captured the moment this package is initialised, which
+// happens on the goroutine that will go on to create the fyne.App and call
+// its Run - Fyne's main, UI-owning goroutine. Fyne exposes no public way to
+// ask "is this the UI goroutine?", so this stack-parsing approach is a
+// pragmatic stand-in - but it rests entirely on that one assumption: a
+// consumer that imports this package from one goroutine and then calls
+// fyne.App.Run from a different one breaks it silently, since
+// onMainGoroutine would report false for the actual UI goroutine and
+// SetStateAsync would run setState off-thread instead of dispatching it
+// through fyne.Do.
+var mainGoroutineID = goroutineID()
+
+// onMainGoroutine reports whether the calling goroutine is the one that
+// initialised this package. See mainGoroutineID for the assumption this
+// relies on.
+func onMainGoroutine() bool {
+	return goroutineID() == mainGoroutineID
+}
+
+func goroutineID() int64 {
+	buf := make([]byte, 64)
+	buf = buf[:runtime.Stack(buf, false)]
+	buf = bytes.TrimPrefix(buf, []byte("goroutine "))
+	buf = buf[:bytes.IndexByte(buf, ' ')]
+
+	id, _ := strconv.ParseInt(string(buf), 10, 64)
+	return id
+}
@@ -0,0 +1,33 @@
+package widget
+
+import "fyne.io/fyne/v2"
+
+// RenderOptions lets a SimpleWidget opt into renderer performance strategies
+// beyond the defaults of recomputing MinSize from its objects and refreshing
+// all of them on every Refresh. It is returned as the third value of Render.
+type RenderOptions struct {
+	// CacheMinSize memoises the result of MinSize until InvalidateMinSize is
+	// called on the widget's SimpleWidgetBase. Useful for widgets whose
+	// MinSize is expensive to compute and doesn't change on every Refresh.
+	CacheMinSize bool
+
+	// MinSizeFunc, if set, is used to compute MinSize instead of combining
+	// the MinSize of every object returned by Render.
+	MinSizeFunc func() fyne.Size
+
+	// DirtyFunc, if set, limits Refresh to the objects it returns instead of
+	// refreshing every object Render returned. Useful for widgets with many
+	// children, such as grids or line plots, where most objects are
+	// unchanged between refreshes.
+	DirtyFunc func() []fyne.CanvasObject
+
+	// Reconcile, if set, re-runs Render on every Refresh instead of just
+	// refreshing the existing objects. Set this when Render returns its
+	// objects via Tree, so entries added or removed since the last Render
+	// take effect. Leave it unset for the normal pattern of creating objects
+	// once in Render and only mutating them afterwards - re-running Render
+	// for those widgets would recreate every object on every Refresh,
+	// discarding their state (e.g. an Entry's text, focus or scroll
+	// position) for no benefit.
+	Reconcile bool
+}
@@ -0,0 +1,157 @@
+package widget
+
+import (
+	"sync"
+
+	"fyne.io/fyne/v2"
+)
+
+// onMounter is detected via type assertion on a SimpleWidget. If implemented,
+// OnMount is called the first time CreateRenderer runs for the widget, and
+// again on the first CreateRenderer after the renderer's Destroy.
+type onMounter interface {
+	OnMount()
+}
+
+// onUnmounter is detected via type assertion on a SimpleWidget. If
+// implemented, OnUnmount is called from the renderer's Destroy.
+type onUnmounter interface {
+	OnUnmount()
+}
+
+// onThemeChanger is detected via type assertion on a SimpleWidget. If
+// implemented, OnThemeChanged is called when Refresh notices the current
+// theme differs from the one seen on the previous Refresh.
+type onThemeChanger interface {
+	OnThemeChanged(theme fyne.Theme)
+}
+
+// onResizer is detected via type assertion on a SimpleWidget. If
+// implemented, OnResize is called with the previous and new size before
+// layout runs.
+type onResizer interface {
+	OnResize(old, new fyne.Size)
+}
+
+// simpleRenderer is the fyne.WidgetRenderer used for every SimpleWidget. It
+// is created by SimpleWidgetBase.CreateRenderer and should not need to be
+// implemented or called manually.
+type simpleRenderer struct {
+	base    *SimpleWidgetBase
+	widget  SimpleWidget
+	objects []fyne.CanvasObject
+	layout  func(fyne.Size)
+	opts    RenderOptions
+
+	size  fyne.Size
+	theme fyne.Theme
+
+	minSizeLock  sync.Mutex
+	minSize      fyne.Size
+	minSizeValid bool
+}
+
+func newSimpleRenderer(base *SimpleWidgetBase, wdgt SimpleWidget, objects []fyne.CanvasObject, layout func(fyne.Size), opts RenderOptions) *simpleRenderer {
+	return &simpleRenderer{
+		base:    base,
+		widget:  wdgt,
+		objects: objects,
+		layout:  layout,
+		opts:    opts,
+		theme:   fyne.CurrentApp().Settings().Theme(),
+	}
+}
+
+// Destroy runs OnUnmount, pairing the OnMount CreateRenderer triggered for
+// this widget instance. It also clears the mounted flag on base, so a widget
+// that is shown again after being destroyed (Fyne may call CreateRenderer a
+// second time for the same instance) gets a fresh OnMount/OnUnmount pair
+// rather than being treated as still mounted. Any animation started via
+// Animate, AnimateValue or SetStateAnimated is stopped too, so a torn-down
+// widget doesn't keep mutating its own fields and queuing refreshes for the
+// rest of the animation's duration.
+func (r *simpleRenderer) Destroy() {
+	if u, ok := r.widget.(onUnmounter); ok {
+		u.OnUnmount()
+	}
+
+	r.base.mountLock.Lock()
+	r.base.mounted = false
+	r.base.mountLock.Unlock()
+
+	r.base.stopAnimations()
+}
+
+func (r *simpleRenderer) Layout(size fyne.Size) {
+	if rs, ok := r.widget.(onResizer); ok {
+		rs.OnResize(r.size, size)
+	}
+	r.size = size
+
+	r.layout(size)
+}
+
+func (r *simpleRenderer) MinSize() fyne.Size {
+	if !r.opts.CacheMinSize {
+		return r.computeMinSize()
+	}
+
+	r.minSizeLock.Lock()
+	defer r.minSizeLock.Unlock()
+
+	if !r.minSizeValid {
+		r.minSize = r.computeMinSize()
+		r.minSizeValid = true
+	}
+	return r.minSize
+}
+
+func (r *simpleRenderer) computeMinSize() fyne.Size {
+	if r.opts.MinSizeFunc != nil {
+		return r.opts.MinSizeFunc()
+	}
+
+	min := fyne.NewSize(0, 0)
+	for _, o := range r.objects {
+		min = min.Max(o.MinSize())
+	}
+	return min
+}
+
+func (r *simpleRenderer) invalidateMinSize() {
+	r.minSizeLock.Lock()
+	r.minSizeValid = false
+	r.minSizeLock.Unlock()
+}
+
+func (r *simpleRenderer) Objects() []fyne.CanvasObject {
+	return r.objects
+}
+
+// Refresh refreshes the objects Render previously returned. If opts.Reconcile
+// is set (the declarative Tree pattern), it re-runs Render first so objects
+// it adds or drops take effect; otherwise the existing objects - created
+// once in Render and only mutated since - are kept as-is and simply
+// refreshed in place.
+func (r *simpleRenderer) Refresh() {
+	if r.opts.Reconcile {
+		r.objects, r.layout, r.opts = r.widget.Render()
+		r.layout(r.size)
+	}
+
+	if theme := fyne.CurrentApp().Settings().Theme(); theme != r.theme {
+		r.theme = theme
+		if t, ok := r.widget.(onThemeChanger); ok {
+			t.OnThemeChanged(theme)
+		}
+	}
+
+	objects := r.objects
+	if r.opts.DirtyFunc != nil {
+		objects = r.opts.DirtyFunc()
+	}
+
+	for _, o := range objects {
+		o.Refresh()
+	}
+}
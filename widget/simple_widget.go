@@ -14,7 +14,7 @@ import (
 type SimpleWidget interface {
 	fyne.Widget
 
-	Render() ([]fyne.CanvasObject, func(size fyne.Size))
+	Render() ([]fyne.CanvasObject, func(size fyne.Size), RenderOptions)
 }
 
 // SimpleWidgetBase defines the base for a SimpleWidget implementation.
@@ -23,22 +23,47 @@ type SimpleWidget interface {
 // ExtendBaseWidget in the New function. Always use the `New` function to
 // create the widget or make sure `ExtendBaseWidget` is called elsewhere.
 //
-// Overwrite the `Render() (objects []fyne.CanvasObject, layout func(size fyne.Size))`
+// Overwrite the `Render() (objects []fyne.CanvasObject, layout func(size fyne.Size), options RenderOptions)`
 // function. It returns the (base-)objects needed to render the widgets content,
-// as well as a function `layout` responsible for positioning and resizing the
-// different objects based on the incoming available space for the widget.
+// a function `layout` responsible for positioning and resizing the
+// different objects based on the incoming available space for the widget,
+// and a RenderOptions value letting the widget opt into renderer performance
+// strategies such as a cached MinSize or dirty-region refresh. The zero value
+// of RenderOptions keeps the previous behaviour (MinSize recomputed from the
+// objects on every call, Refresh touching every object).
 // Try not to define new objects in the `layout` function as they would be
 // recreated every time the widget is refreshed.
 //
 // Other functions defined by the fyne.Widget interface can be overwritten
 // and will be used by the SimpleWidgetBase if overwritten.
 //
+// A widget can also optionally implement OnMount(), OnUnmount(),
+// OnThemeChanged(theme fyne.Theme) and OnResize(old, new fyne.Size). These
+// are detected via type assertion, so they stay optional, and are called by
+// the renderer around CreateRenderer, Destroy, Refresh and Layout
+// respectively - a natural place to start/stop goroutines, subscribe to
+// bindings or preload resources for the widget's visible lifetime.
+//
 // See ./example/simple_wigdet.go for a bootstraped widget implementation.
 type SimpleWidgetBase struct {
 	widget.BaseWidget
 
 	propertyLock sync.RWMutex
 	impl         SimpleWidget
+
+	animLock   sync.Mutex
+	animations map[string]*fyne.Animation
+
+	renderer *simpleRenderer
+
+	treeLock    sync.Mutex
+	treeObjects map[string]fyne.Widget
+
+	refreshLock    sync.Mutex
+	refreshPending bool
+
+	mountLock sync.Mutex
+	mounted   bool
 }
 
 // Render must be overwritten in a widget to create other widgets and
@@ -48,8 +73,8 @@ type SimpleWidgetBase struct {
 // and canvas objects). New objects should be created in the Render function body
 // outside the returned layout function, so they are not re-created
 // every time the widget gets refreshed.
-func (s *SimpleWidgetBase) Render() (objects []fyne.CanvasObject, layout func(size fyne.Size)) {
-	return nil, func(fyne.Size) {}
+func (s *SimpleWidgetBase) Render() (objects []fyne.CanvasObject, layout func(size fyne.Size), options RenderOptions) {
+	return nil, func(fyne.Size) {}, RenderOptions{}
 }
 
 // CreateRenderer implements the Widget interface. It creates a simpleRenderer
@@ -58,28 +83,103 @@ func (s *SimpleWidgetBase) Render() (objects []fyne.CanvasObject, layout func(si
 // Usually this should not be overwritten or called manually.
 func (s *SimpleWidgetBase) CreateRenderer() fyne.WidgetRenderer {
 	wdgt := s.super()
-	objs, layout := wdgt.Render()
+	objs, layout, opts := wdgt.Render()
+
+	r := newSimpleRenderer(s, wdgt, objs, layout, opts)
+
+	s.mountLock.Lock()
+	firstMount := !s.mounted
+	s.mounted = true
+	s.mountLock.Unlock()
 
-	return newSimpleRenderer(wdgt, objs, layout)
+	if firstMount {
+		if m, ok := wdgt.(onMounter); ok {
+			m.OnMount()
+		}
+	}
+
+	s.propertyLock.Lock()
+	s.renderer = r
+	s.propertyLock.Unlock()
+
+	return r
 }
 
-// SetState sets or changes the state of a widget. A Refresh
-// is triggered after the state changes have been applied.
+// InvalidateMinSize discards a cached MinSize set up via RenderOptions.CacheMinSize,
+// forcing the next MinSize call to recompute it. It is a no-op if the widget
+// has no renderer yet or didn't opt into caching.
+func (s *SimpleWidgetBase) InvalidateMinSize() {
+	s.propertyLock.RLock()
+	r := s.renderer
+	s.propertyLock.RUnlock()
+
+	if r != nil {
+		r.invalidateMinSize()
+	}
+}
+
+// SetState sets or changes the state of a widget. A Refresh is triggered
+// after the state changes have been applied, dispatched through fyne.Do and
+// coalesced with any other pending refresh from the same event-loop tick,
+// whether SetState is called from the main goroutine or any other.
 func (s *SimpleWidgetBase) SetState(setState func()) {
 	setState()
-	s.super().Refresh()
+	s.queueRefresh()
 }
 
 // SetStateSafe sets or changes the state of a widget in a safe way. A Refresh
-// is triggered after the state changes have been applied.
-// The provided sync.Locker should be the same you use for read protection of the
-// widget properties.
+// is triggered after the state changes have been applied, coalesced the same
+// way as SetState. The provided sync.Locker should be the same you use for
+// read protection of the widget properties.
 func (s *SimpleWidgetBase) SetStateSafe(m sync.Locker, setState func()) {
 	m.Lock()
 	setState()
 	m.Unlock()
 
-	s.super().Refresh()
+	s.queueRefresh()
+}
+
+// SetStateAsync is like SetState, but also runs setState itself through
+// fyne.Do when called off the main goroutine, instead of calling it
+// directly. Use it when setState touches Fyne objects or widget properties
+// that are not otherwise safe to mutate off the main goroutine. On the main
+// goroutine setState runs immediately, the same as SetState; either way the
+// Refresh is coalesced through queueRefresh.
+func (s *SimpleWidgetBase) SetStateAsync(setState func()) {
+	if onMainGoroutine() {
+		setState()
+		s.queueRefresh()
+		return
+	}
+
+	fyne.Do(func() {
+		setState()
+		s.queueRefresh()
+	})
+}
+
+// queueRefresh triggers a Refresh through fyne.Do, collapsing multiple calls
+// made within the same event-loop tick into a single Refresh. This always
+// goes through fyne.Do, even when already called from the main goroutine:
+// running Refresh immediately in that case would defeat coalescing, since
+// several SetState calls issued back-to-back on the main goroutine need to
+// collapse into each other just as much as ones from a background goroutine.
+func (s *SimpleWidgetBase) queueRefresh() {
+	s.refreshLock.Lock()
+	if s.refreshPending {
+		s.refreshLock.Unlock()
+		return
+	}
+	s.refreshPending = true
+	s.refreshLock.Unlock()
+
+	fyne.Do(func() {
+		s.refreshLock.Lock()
+		s.refreshPending = false
+		s.refreshLock.Unlock()
+
+		s.super().Refresh()
+	})
 }
 
 // ExtendBaseWidget is used by an extending widget to make use of BaseWidget functionality.
@@ -0,0 +1,106 @@
+package widget
+
+import (
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+)
+
+// countingWidget is a minimal SimpleWidget that counts how many times
+// Refresh actually runs, for exercising queueRefresh's coalescing.
+type countingWidget struct {
+	SimpleWidgetBase
+	refreshes int32
+}
+
+func newCountingWidget() *countingWidget {
+	w := &countingWidget{}
+	w.ExtendBaseWidget(w)
+	return w
+}
+
+func (w *countingWidget) Render() ([]fyne.CanvasObject, func(fyne.Size), RenderOptions) {
+	return nil, func(fyne.Size) {}, RenderOptions{}
+}
+
+func (w *countingWidget) Refresh() {
+	atomic.AddInt32(&w.refreshes, 1)
+	w.SimpleWidgetBase.Refresh()
+}
+
+// TestSetStateCoalescesAcrossGoroutines fires SetState concurrently from many
+// background goroutines and checks the result is race-free, that
+// queueRefresh dispatches at least one Refresh, and that it never dispatches
+// more Refresh calls than there were SetState calls.
+func TestSetStateCoalescesAcrossGoroutines(t *testing.T) {
+	test.NewApp()
+
+	w := newCountingWidget()
+	w.CreateRenderer()
+
+	const calls = 50
+	var wg sync.WaitGroup
+	wg.Add(calls)
+	for i := 0; i < calls; i++ {
+		go func() {
+			defer wg.Done()
+			w.SetState(func() {})
+		}()
+	}
+	wg.Wait()
+
+	deadline := time.After(time.Second)
+	for atomic.LoadInt32(&w.refreshes) == 0 {
+		select {
+		case <-deadline:
+			t.Fatal("queueRefresh never dispatched a Refresh")
+		case <-time.After(time.Millisecond):
+		}
+	}
+
+	if got := atomic.LoadInt32(&w.refreshes); got > calls {
+		t.Fatalf("got %d refreshes, want at most %d", got, calls)
+	}
+}
+
+// TestSetStateAnimatedDiscoversFieldsOnSuper checks that SetStateAnimated
+// finds the fields to ease on the widget returned by s.super(), not on a
+// separately passed struct.
+type tintedWidget struct {
+	SimpleWidgetBase
+	Opacity float32
+}
+
+func (w *tintedWidget) Render() ([]fyne.CanvasObject, func(fyne.Size), RenderOptions) {
+	return nil, func(fyne.Size) {}, RenderOptions{}
+}
+
+func TestSetStateAnimatedDiscoversFieldsOnSuper(t *testing.T) {
+	test.NewApp()
+
+	w := &tintedWidget{}
+	w.ExtendBaseWidget(w)
+	w.CreateRenderer()
+
+	if _, ok := snapshotAnimatableFields(reflect.ValueOf(w.super()).Elem())["Opacity"]; !ok {
+		t.Fatal("Opacity was not discovered on the widget returned by super()")
+	}
+
+	w.SetStateAnimated("opacity", time.Millisecond, fyne.AnimationLinear, func() {
+		w.Opacity = 1
+	})
+
+	deadline := time.After(time.Second)
+	for w.Opacity != 1 {
+		select {
+		case <-deadline:
+			t.Fatalf("Opacity never reached its target, stuck at %v", w.Opacity)
+		case <-time.After(time.Millisecond):
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package widget
+
+import (
+	"sync"
+
+	"fyne.io/fyne/v2/data/binding"
+)
+
+// State holds a single reactive value owned by a widget built on
+// SimpleWidgetBase. Changing the value through Set triggers a Refresh of the
+// owning widget, so Render can simply close over the State (or call Get) and
+// always observe the current value, without the widget author having to call
+// SetState/SetStateSafe by hand. Bind feeds a State from a fyne
+// binding.DataItem, so an external data source updates the widget without
+// the widget needing to know about bindings at all.
+//
+// Create a State with UseState.
+type State[T any] struct {
+	mu        sync.RWMutex
+	value     T
+	listeners []func(T)
+
+	onChange func()
+}
+
+// Get returns the current value of the state.
+func (s *State[T]) Get() T {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.value
+}
+
+// Set updates the state's value, notifies any registered listeners and
+// triggers a Refresh of the owning widget.
+func (s *State[T]) Set(value T) {
+	s.mu.Lock()
+	s.value = value
+	listeners := make([]func(T), len(s.listeners))
+	copy(listeners, s.listeners)
+	onChange := s.onChange
+	s.mu.Unlock()
+
+	for _, listener := range listeners {
+		listener(value)
+	}
+	if onChange != nil {
+		onChange()
+	}
+}
+
+// AddListener registers fn to be called, with the new value, whenever Set
+// changes the state. Use this to propagate a State's value outward, e.g. to
+// a fyne binding.DataItem; use Bind for the opposite direction.
+func (s *State[T]) AddListener(fn func(value T)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.listeners = append(s.listeners, fn)
+}
+
+// Bind subscribes to a fyne binding.DataItem (binding.String, binding.Float,
+// ...), calling get to read its current value and feeding it into Set every
+// time item reports a change. get typically wraps the typed accessor the
+// concrete binding exposes, e.g.
+// `state.Bind(boundStr, func() (string, error) { return boundStr.Get() })`.
+// A binding.DataItem calls its listeners once immediately on AddListener, so
+// s already holds item's current value once Bind returns. Errors from get
+// are ignored, leaving the state at its last known-good value, the same way
+// Fyne's own bound widgets handle a failing Get.
+func (s *State[T]) Bind(item binding.DataItem, get func() (T, error)) {
+	item.AddListener(binding.NewDataListener(func() {
+		if value, err := get(); err == nil {
+			s.Set(value)
+		}
+	}))
+}
+
+// UseState declares a new State owned by s, initialised to initial. Whenever
+// the returned State changes via Set, s is refreshed automatically, so
+// Render can read the State without any manual SetState/SetStateSafe
+// boilerplate. The refresh is dispatched through s's queued refresh, so Set
+// is safe to call from any goroutine - the natural way to feed a State from
+// a fyne binding.DataItem listener, which fires off the main thread.
+//
+// UseState is a plain function rather than a method on SimpleWidgetBase
+// because Go methods cannot carry their own type parameters.
+func UseState[T any](s *SimpleWidgetBase, initial T) *State[T] {
+	state := &State[T]{value: initial}
+	state.onChange = func() {
+		s.queueRefresh()
+	}
+	return state
+}
@@ -0,0 +1,108 @@
+package widget
+
+import (
+	"fmt"
+
+	"fyne.io/fyne/v2"
+)
+
+// treeEntry is a single keyed node registered with a TreeBuilder.
+type treeEntry struct {
+	key    string
+	create func() fyne.Widget
+	update func(w fyne.Widget)
+}
+
+// Widget sets the function used to create the entry's object the first time
+// its key appears across renders.
+func (e *treeEntry) Widget(create func() fyne.Widget) *treeEntry {
+	e.create = create
+	return e
+}
+
+// Update sets the function used to refresh the entry's object on every
+// render, including the one where it is first created.
+func (e *treeEntry) Update(update func(w fyne.Widget)) *treeEntry {
+	e.update = update
+	return e
+}
+
+// TreeBuilder collects a declarative, keyed list of children for Tree. Start
+// each child with Key, then chain Widget and Update to describe how it is
+// created and refreshed.
+type TreeBuilder struct {
+	entries []*treeEntry
+}
+
+// Key starts a new keyed entry. Entries keep their identity across renders
+// by key, so reordering or changing other arguments around a Key call does
+// not recreate its object.
+func (b *TreeBuilder) Key(key string) *treeEntry {
+	e := &treeEntry{key: key}
+	b.entries = append(b.entries, e)
+	return e
+}
+
+// Tree lets Render return a declarative tree of keyed children that is
+// diffed across renders instead of rebuilt from scratch: an entry whose key
+// matches a previous render reuses the existing fyne.CanvasObject and only
+// calls its Update, an entry with a new key is created via its Widget
+// function, and objects whose key is no longer present are dropped. This
+// keeps the "don't create objects in the layout func" rule Render already
+// asks for, while still letting Render describe its children declaratively.
+//
+// Tree returns the resulting objects together with a no-op layout. Since
+// Render also returns a RenderOptions, Tree's two return values can't be
+// returned from Render directly - pair them with RenderOptions{Reconcile:
+// true}, e.g. `objects, layout := s.Tree(...); return objects, layout,
+// RenderOptions{Reconcile: true}`, or use the layout to position objects
+// yourself and ignore Tree's.
+//
+// Render only runs again on Refresh when RenderOptions.Reconcile is set, so
+// a Tree-based widget must return it - otherwise children added or removed
+// since the last Render won't take effect until the renderer is recreated.
+func (s *SimpleWidgetBase) Tree(build func(b *TreeBuilder)) ([]fyne.CanvasObject, func(fyne.Size)) {
+	b := &TreeBuilder{}
+	build(b)
+
+	s.treeLock.Lock()
+	defer s.treeLock.Unlock()
+
+	if s.treeObjects == nil {
+		s.treeObjects = make(map[string]fyne.Widget)
+	}
+
+	objects := make([]fyne.CanvasObject, 0, len(b.entries))
+	seen := make(map[string]bool, len(b.entries))
+	for _, e := range b.entries {
+		seen[e.key] = true
+
+		w, ok := s.treeObjects[e.key]
+		if !ok {
+			if e.create == nil {
+				panic(fmt.Sprintf("widget: Tree entry %q has no Widget function to create it", e.key))
+			}
+			w = e.create()
+			s.treeObjects[e.key] = w
+		}
+
+		if e.update != nil {
+			e.update(w)
+		}
+
+		objects = append(objects, w)
+	}
+
+	// Entries whose key is no longer present are only dropped from
+	// treeObjects, not explicitly destroyed: since they're excluded from the
+	// returned objects, the renderer stops laying them out and refreshing
+	// them, and Fyne releases them the same way it does for any widget that
+	// a renderer stops returning from Objects().
+	for key := range s.treeObjects {
+		if !seen[key] {
+			delete(s.treeObjects, key)
+		}
+	}
+
+	return objects, func(fyne.Size) {}
+}
@@ -0,0 +1,86 @@
+package widget
+
+import (
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/widget"
+)
+
+type treeWidget struct {
+	SimpleWidgetBase
+
+	keys    []string
+	updated []string
+}
+
+func (w *treeWidget) Render() ([]fyne.CanvasObject, func(fyne.Size), RenderOptions) {
+	objects, layout := w.Tree(func(b *TreeBuilder) {
+		for _, key := range w.keys {
+			key := key
+			b.Key(key).
+				Widget(func() fyne.Widget { return widget.NewLabel("") }).
+				Update(func(fyne.Widget) { w.updated = append(w.updated, key) })
+		}
+	})
+	return objects, layout, RenderOptions{Reconcile: true}
+}
+
+func TestTreeReconciliation(t *testing.T) {
+	w := &treeWidget{keys: []string{"a", "b"}}
+	w.ExtendBaseWidget(w)
+
+	first, _, _ := w.Render()
+	if len(first) != 2 {
+		t.Fatalf("got %d objects, want 2", len(first))
+	}
+	if got := w.updated; len(got) != 2 || got[0] != "a" || got[1] != "b" {
+		t.Fatalf("Update not called for every new key, got %v", got)
+	}
+	w.updated = nil
+
+	// Second render: drop "a", keep "b", add "c". "b" must keep its object
+	// identity; "c" must be a new object; "a" must not be returned anymore.
+	w.keys = []string{"b", "c"}
+	second, _, _ := w.Render()
+
+	if len(second) != 2 {
+		t.Fatalf("got %d objects, want 2", len(second))
+	}
+	if second[0] != first[1] {
+		t.Fatalf("matched key %q did not reuse its previous object", "b")
+	}
+	if second[1] == first[0] || second[1] == first[1] {
+		t.Fatalf("new key %q unexpectedly reused an existing object", "c")
+	}
+	if got := w.updated; len(got) != 2 || got[0] != "b" || got[1] != "c" {
+		t.Fatalf("Update not called for matched and new keys, got %v", got)
+	}
+
+	w.treeLock.Lock()
+	_, stillTracked := w.treeObjects["a"]
+	tracked := len(w.treeObjects)
+	w.treeLock.Unlock()
+
+	if stillTracked {
+		t.Fatalf("dropped key %q is still tracked", "a")
+	}
+	if tracked != 2 {
+		t.Fatalf("got %d tracked objects, want 2", tracked)
+	}
+}
+
+func TestTreeEntryWithoutWidgetFuncPanics(t *testing.T) {
+	w := &treeWidget{}
+	w.ExtendBaseWidget(w)
+
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected a panic for a Tree entry with no Widget function")
+		}
+	}()
+
+	w.Tree(func(b *TreeBuilder) {
+		b.Key("missing-widget")
+	})
+}